@@ -0,0 +1,115 @@
+package azuredevops
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/parse"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/config"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/suppress"
+)
+
+func dataAgentQueue() *schema.Resource {
+	return &schema.Resource{
+		Read: dataAgentQueueRead,
+		Schema: map[string]*schema.Schema{
+			projectID: {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.NoZeroValues,
+				DiffSuppressFunc: suppress.CaseDifference,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			agentPoolID: {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataAgentQueueRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*config.AggregatedClient)
+	project := d.Get(projectID).(string)
+	name := d.Get("name").(string)
+
+	queue, err := lookupAgentQueueByName(clients, project, name)
+	if err != nil {
+		return err
+	}
+
+	if queue.Id == nil {
+		return fmt.Errorf("Agent queue %q in project %q unexpectedly had a nil ID", name, project)
+	}
+
+	d.SetId(parse.NewAgentQueueID(project, *queue.Id).ID())
+	if queue.Pool != nil && queue.Pool.Id != nil {
+		d.Set(agentPoolID, *queue.Pool.Id)
+	}
+
+	return nil
+}
+
+// lookupAgentQueueByName resolves an agent queue by its name within a project, preferring the
+// `GetAgentQueuesByNames` API (which avoids paging) and falling back to filtering the paged
+// `GetAgentQueues` results client-side when it returns nothing usable.
+func lookupAgentQueueByName(clients *config.AggregatedClient, project string, name string) (*taskagent.TaskAgentQueue, error) {
+	queues, err := clients.TaskAgentClient.GetAgentQueuesByNames(clients.Ctx, taskagent.GetAgentQueuesByNamesArgs{
+		Queues:  &[]string{name},
+		Project: &project,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error looking up agent queue named %q: %+v", name, err)
+	}
+
+	if queues == nil || len(*queues) == 0 {
+		allQueues, err := clients.TaskAgentClient.GetAgentQueues(clients.Ctx, taskagent.GetAgentQueuesArgs{
+			Project: &project,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("Error reading agent queues: %+v", err)
+		}
+
+		matches := filterAgentQueuesByName(allQueues, name)
+		queues = &matches
+	}
+
+	return selectSingleAgentQueue(*queues, project, name)
+}
+
+// filterAgentQueuesByName returns the queues in allQueues whose name matches name exactly, used
+// to filter the paged GetAgentQueues fallback client-side.
+func filterAgentQueuesByName(allQueues *[]taskagent.TaskAgentQueue, name string) []taskagent.TaskAgentQueue {
+	matches := []taskagent.TaskAgentQueue{}
+	if allQueues == nil {
+		return matches
+	}
+
+	for _, q := range *allQueues {
+		if q.Name != nil && *q.Name == name {
+			matches = append(matches, q)
+		}
+	}
+
+	return matches
+}
+
+// selectSingleAgentQueue picks the single queue matching name out of queues, erroring clearly on
+// zero or multiple matches.
+func selectSingleAgentQueue(queues []taskagent.TaskAgentQueue, project string, name string) (*taskagent.TaskAgentQueue, error) {
+	if len(queues) == 0 {
+		return nil, fmt.Errorf("Could not find an agent queue named %q in project %q", name, project)
+	}
+	if len(queues) > 1 {
+		return nil, fmt.Errorf("Found multiple agent queues named %q in project %q", name, project)
+	}
+
+	found := queues[0]
+	return &found, nil
+}
@@ -0,0 +1,72 @@
+package azuredevops
+
+import (
+	"testing"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/taskagent"
+)
+
+func TestFilterAgentQueuesByName(t *testing.T) {
+	matching := "Default"
+	other := "Hosted"
+
+	queues := &[]taskagent.TaskAgentQueue{
+		{Name: &matching},
+		{Name: &other},
+		{Name: &matching},
+	}
+
+	got := filterAgentQueuesByName(queues, matching)
+	if len(got) != 2 {
+		t.Fatalf("expected 2 matches but got %d", len(got))
+	}
+
+	if len(filterAgentQueuesByName(nil, matching)) != 0 {
+		t.Fatalf("expected no matches for nil input")
+	}
+}
+
+func TestSelectSingleAgentQueue(t *testing.T) {
+	queueID := 42
+
+	cases := []struct {
+		Name   string
+		Queues []taskagent.TaskAgentQueue
+		Error  bool
+	}{
+		{
+			Name:   "zero matches",
+			Queues: []taskagent.TaskAgentQueue{},
+			Error:  true,
+		},
+		{
+			Name:   "single match",
+			Queues: []taskagent.TaskAgentQueue{{Id: &queueID}},
+		},
+		{
+			Name:   "multiple matches",
+			Queues: []taskagent.TaskAgentQueue{{Id: &queueID}, {Id: &queueID}},
+			Error:  true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			got, err := selectSingleAgentQueue(tc.Queues, "my-project", "Default")
+			if tc.Error {
+				if err == nil {
+					t.Fatalf("expected an error but got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+
+			if got == nil || got.Id == nil || *got.Id != queueID {
+				t.Fatalf("expected queue ID %d but got %+v", queueID, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package parse
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// AgentPoolId is a struct representing the Resource ID for an Agent Pool
+type AgentPoolId struct {
+	PoolId int
+}
+
+// NewAgentPoolID returns a new AgentPoolId struct
+func NewAgentPoolID(poolId int) AgentPoolId {
+	return AgentPoolId{
+		PoolId: poolId,
+	}
+}
+
+// ID returns the formatted Agent Pool ID
+func (id AgentPoolId) ID() string {
+	return strconv.Itoa(id.PoolId)
+}
+
+// AgentPoolID parses an AgentPoolId from the given string
+func AgentPoolID(input string) (*AgentPoolId, error) {
+	poolID, err := strconv.Atoi(input)
+	if err != nil {
+		return nil, fmt.Errorf("pool ID %q wasn't a valid integer: %+v", input, err)
+	}
+
+	return &AgentPoolId{
+		PoolId: poolID,
+	}, nil
+}
@@ -0,0 +1,55 @@
+package parse
+
+import "testing"
+
+func TestAgentPoolID(t *testing.T) {
+	cases := []struct {
+		Name  string
+		Input string
+		Error bool
+		Want  int
+	}{
+		{
+			Name:  "empty string",
+			Input: "",
+			Error: true,
+		},
+		{
+			Name:  "not an integer",
+			Input: "Default",
+			Error: true,
+		},
+		{
+			Name:  "valid",
+			Input: "123",
+			Want:  123,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			got, err := AgentPoolID(tc.Input)
+			if tc.Error {
+				if err == nil {
+					t.Fatalf("expected an error but got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+
+			if got.PoolId != tc.Want {
+				t.Fatalf("expected %d but got %d", tc.Want, got.PoolId)
+			}
+		})
+	}
+}
+
+func TestNewAgentPoolID(t *testing.T) {
+	id := NewAgentPoolID(123)
+	if id.ID() != "123" {
+		t.Fatalf("expected %q but got %q", "123", id.ID())
+	}
+}
@@ -0,0 +1,45 @@
+package parse
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// AgentQueueId is a struct representing the Resource ID for an Agent Queue
+type AgentQueueId struct {
+	Project string
+	QueueId int
+}
+
+// NewAgentQueueID returns a new AgentQueueId struct
+func NewAgentQueueID(project string, queueId int) AgentQueueId {
+	return AgentQueueId{
+		Project: project,
+		QueueId: queueId,
+	}
+}
+
+// ID returns the formatted Agent Queue ID
+func (id AgentQueueId) ID() string {
+	return fmt.Sprintf("%s/%d", id.Project, id.QueueId)
+}
+
+// AgentQueueID parses an AgentQueueId from the given string, expected in the
+// format `{project}/{queueId}`
+func AgentQueueID(input string) (*AgentQueueId, error) {
+	parts := strings.SplitN(input, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("expected ID in the format `{project}/{queueId}`, got %q", input)
+	}
+
+	queueID, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("queue ID %q wasn't a valid integer: %+v", parts[1], err)
+	}
+
+	return &AgentQueueId{
+		Project: parts[0],
+		QueueId: queueID,
+	}, nil
+}
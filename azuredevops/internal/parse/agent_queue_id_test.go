@@ -0,0 +1,68 @@
+package parse
+
+import "testing"
+
+func TestAgentQueueID(t *testing.T) {
+	cases := []struct {
+		Name  string
+		Input string
+		Error bool
+		Want  AgentQueueId
+	}{
+		{
+			Name:  "empty string",
+			Input: "",
+			Error: true,
+		},
+		{
+			Name:  "missing queue segment",
+			Input: "my-project",
+			Error: true,
+		},
+		{
+			Name:  "missing project segment",
+			Input: "/123",
+			Error: true,
+		},
+		{
+			Name:  "queue segment is not an integer",
+			Input: "my-project/Default",
+			Error: true,
+		},
+		{
+			Name:  "valid",
+			Input: "my-project/123",
+			Want: AgentQueueId{
+				Project: "my-project",
+				QueueId: 123,
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			got, err := AgentQueueID(tc.Input)
+			if tc.Error {
+				if err == nil {
+					t.Fatalf("expected an error but got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+
+			if got.Project != tc.Want.Project || got.QueueId != tc.Want.QueueId {
+				t.Fatalf("expected %+v but got %+v", tc.Want, *got)
+			}
+		})
+	}
+}
+
+func TestNewAgentQueueID(t *testing.T) {
+	id := NewAgentQueueID("my-project", 123)
+	if id.ID() != "my-project/123" {
+		t.Fatalf("expected %q but got %q", "my-project/123", id.ID())
+	}
+}
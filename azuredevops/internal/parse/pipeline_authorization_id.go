@@ -0,0 +1,63 @@
+package parse
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// PipelineAuthorizationId is a struct representing the Resource ID for a pipeline authorization
+type PipelineAuthorizationId struct {
+	Project      string
+	ResourceType string
+	ResourceId   string
+	PipelineId   *int
+}
+
+// NewPipelineAuthorizationID returns a new PipelineAuthorizationId struct. Pass a nil pipelineId
+// for an all-pipelines authorization.
+func NewPipelineAuthorizationID(project string, resourceType string, resourceId string, pipelineId *int) PipelineAuthorizationId {
+	return PipelineAuthorizationId{
+		Project:      project,
+		ResourceType: resourceType,
+		ResourceId:   resourceId,
+		PipelineId:   pipelineId,
+	}
+}
+
+// ID returns the formatted Pipeline Authorization ID
+func (id PipelineAuthorizationId) ID() string {
+	if id.PipelineId != nil {
+		return fmt.Sprintf("%s/%s/%s/%d", id.Project, id.ResourceType, id.ResourceId, *id.PipelineId)
+	}
+	return fmt.Sprintf("%s/%s/%s", id.Project, id.ResourceType, id.ResourceId)
+}
+
+// PipelineAuthorizationID parses a PipelineAuthorizationId from the given string, expected in the
+// format `{project}/{resourceType}/{resourceId}` or `{project}/{resourceType}/{resourceId}/{pipelineId}`
+func PipelineAuthorizationID(input string) (*PipelineAuthorizationId, error) {
+	parts := strings.SplitN(input, "/", 4)
+	if len(parts) < 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return nil, fmt.Errorf("expected ID in the format `{project}/{resourceType}/{resourceId}[/{pipelineId}]`, got %q", input)
+	}
+
+	id := PipelineAuthorizationId{
+		Project:      parts[0],
+		ResourceType: parts[1],
+		ResourceId:   parts[2],
+	}
+
+	if len(parts) == 4 {
+		if parts[3] == "" {
+			return nil, fmt.Errorf("expected ID in the format `{project}/{resourceType}/{resourceId}[/{pipelineId}]`, got %q", input)
+		}
+
+		pipelineID, err := strconv.Atoi(parts[3])
+		if err != nil {
+			return nil, fmt.Errorf("pipeline ID %q wasn't a valid integer: %+v", parts[3], err)
+		}
+		id.PipelineId = &pipelineID
+	}
+
+	return &id, nil
+}
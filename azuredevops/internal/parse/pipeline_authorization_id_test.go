@@ -0,0 +1,95 @@
+package parse
+
+import "testing"
+
+func TestPipelineAuthorizationID(t *testing.T) {
+	pipelineID := 99
+
+	cases := []struct {
+		Name  string
+		Input string
+		Error bool
+		Want  PipelineAuthorizationId
+	}{
+		{
+			Name:  "empty string",
+			Input: "",
+			Error: true,
+		},
+		{
+			Name:  "missing resource id segment",
+			Input: "my-project/queue",
+			Error: true,
+		},
+		{
+			Name:  "trailing slash with empty pipeline id",
+			Input: "my-project/queue/42/",
+			Error: true,
+		},
+		{
+			Name:  "pipeline id is not an integer",
+			Input: "my-project/queue/42/abc",
+			Error: true,
+		},
+		{
+			Name:  "valid without pipeline id",
+			Input: "my-project/queue/42",
+			Want: PipelineAuthorizationId{
+				Project:      "my-project",
+				ResourceType: "queue",
+				ResourceId:   "42",
+			},
+		},
+		{
+			Name:  "valid with pipeline id",
+			Input: "my-project/queue/42/99",
+			Want: PipelineAuthorizationId{
+				Project:      "my-project",
+				ResourceType: "queue",
+				ResourceId:   "42",
+				PipelineId:   &pipelineID,
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			got, err := PipelineAuthorizationID(tc.Input)
+			if tc.Error {
+				if err == nil {
+					t.Fatalf("expected an error but got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+
+			if got.Project != tc.Want.Project || got.ResourceType != tc.Want.ResourceType || got.ResourceId != tc.Want.ResourceId {
+				t.Fatalf("expected %+v but got %+v", tc.Want, *got)
+			}
+
+			if (tc.Want.PipelineId == nil) != (got.PipelineId == nil) {
+				t.Fatalf("expected PipelineId %v but got %v", tc.Want.PipelineId, got.PipelineId)
+			}
+			if tc.Want.PipelineId != nil && *got.PipelineId != *tc.Want.PipelineId {
+				t.Fatalf("expected PipelineId %d but got %d", *tc.Want.PipelineId, *got.PipelineId)
+			}
+		})
+	}
+}
+
+func TestNewPipelineAuthorizationID(t *testing.T) {
+	pipelineID := 99
+
+	allPipelines := NewPipelineAuthorizationID("my-project", "queue", "42", nil)
+	if allPipelines.ID() != "my-project/queue/42" {
+		t.Fatalf("expected %q but got %q", "my-project/queue/42", allPipelines.ID())
+	}
+
+	singlePipeline := NewPipelineAuthorizationID("my-project", "queue", "42", &pipelineID)
+	if singlePipeline.ID() != "my-project/queue/42/99" {
+		t.Fatalf("expected %q but got %q", "my-project/queue/42/99", singlePipeline.ID())
+	}
+}
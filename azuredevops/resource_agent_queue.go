@@ -7,7 +7,9 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/pipelinepermissions"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/parse"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/config"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
@@ -15,17 +17,27 @@ import (
 )
 
 const (
-	agentPoolID = "agent_pool_id"
-	projectID   = "project_id"
+	agentPoolID          = "agent_pool_id"
+	projectID            = "project_id"
+	authorizePipelines   = "authorize_pipelines"
+	pipelineResourceType = "queue"
 )
 
 func resourceAgentQueue() *schema.Resource {
-	// Note: there is no update API, so all fields will require a new resource
 	return &schema.Resource{
-		Create:   resourceAgentQueueCreate,
-		Read:     resourceAgentQueueRead,
-		Delete:   resourceAgentQueueDelete,
-		Importer: importFunc(),
+		Create:        resourceAgentQueueCreate,
+		Read:          resourceAgentQueueRead,
+		Update:        resourceAgentQueueUpdate,
+		Delete:        resourceAgentQueueDelete,
+		Importer:      importFunc(),
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Version: 0,
+				Type:    resourceAgentQueueSchemaV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceAgentQueueStateUpgradeV0,
+			},
+		},
 		Schema: map[string]*schema.Schema{
 			agentPoolID: {
 				Type:     schema.TypeInt,
@@ -39,13 +51,63 @@ func resourceAgentQueue() *schema.Resource {
 				ValidateFunc:     validation.NoZeroValues,
 				DiffSuppressFunc: suppress.CaseDifference,
 			},
+			authorizePipelines: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 	}
 }
 
+// resourceAgentQueueSchemaV0 is the schema as it existed when the resource ID was still a bare
+// queue ID, before `internal/parse` introduced the `{project}/{queueId}` composite form.
+func resourceAgentQueueSchemaV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			agentPoolID: {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			projectID: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+// resourceAgentQueueStateUpgradeV0 rewrites state written by the bare-integer-ID version of this
+// resource into the `{project}/{queueId}` composite ID, using the `project_id` attribute already
+// present in state. Without this, existing `azuredevops_agent_queue` resources would fail to
+// refresh once the composite ID format landed.
+func resourceAgentQueueStateUpgradeV0(rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	project, ok := rawState[projectID].(string)
+	if !ok || project == "" {
+		return nil, fmt.Errorf("state upgrade failed: %q was missing from state", projectID)
+	}
+
+	oldID, ok := rawState["id"].(string)
+	if !ok || oldID == "" {
+		return nil, fmt.Errorf("state upgrade failed: id was missing from state")
+	}
+
+	queueID, err := strconv.Atoi(oldID)
+	if err != nil {
+		// Already in the composite form; nothing to upgrade.
+		return rawState, nil
+	}
+
+	rawState["id"] = parse.NewAgentQueueID(project, queueID).ID()
+	return rawState, nil
+}
+
 func resourceAgentQueueCreate(d *schema.ResourceData, m interface{}) error {
 	clients := m.(*config.AggregatedClient)
-	queue, projectID, err := expandAgentQueue(d)
+	queue, project, err := expandAgentQueue(d)
+	if err != nil {
+		return err
+	}
 
 	referencedPool, err := azureAgentPoolRead(clients, *queue.Pool.Id)
 	if err != nil {
@@ -55,18 +117,77 @@ func resourceAgentQueueCreate(d *schema.ResourceData, m interface{}) error {
 	queue.Name = referencedPool.Name
 	createdQueue, err := clients.TaskAgentClient.AddAgentQueue(clients.Ctx, taskagent.AddAgentQueueArgs{
 		Queue:              queue,
-		Project:            &projectID,
-		AuthorizePipelines: converter.Bool(false),
+		Project:            &project,
+		AuthorizePipelines: converter.Bool(d.Get(authorizePipelines).(bool)),
 	})
 
 	if err != nil {
 		return fmt.Errorf("Error creating agent queue: %+v", err)
 	}
 
-	d.SetId(strconv.Itoa(*createdQueue.Id))
+	d.SetId(parse.NewAgentQueueID(project, *createdQueue.Id).ID())
+	return resourceAgentQueueRead(d, m)
+}
+
+func resourceAgentQueueUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*config.AggregatedClient)
+	id, err := parse.AgentQueueID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := setAgentQueuePipelineAuthorization(clients, id.Project, id.QueueId, d.Get(authorizePipelines).(bool)); err != nil {
+		return fmt.Errorf("Error updating agent queue: %+v", err)
+	}
+
 	return resourceAgentQueueRead(d, m)
 }
 
+// setAgentQueuePipelineAuthorization toggles the "Grant access permission to all pipelines" flag
+// for the agent queue in-place, rather than requiring the queue to be destroyed and recreated.
+func setAgentQueuePipelineAuthorization(clients *config.AggregatedClient, project string, queueID int, authorized bool) error {
+	resourceID := strconv.Itoa(queueID)
+	_, err := clients.PipelinePermissionsClient.UpdatePipelinePermissionsForResource(clients.Ctx, pipelinepermissions.UpdatePipelinePermissionsForResourceArgs{
+		Project:      &project,
+		ResourceType: converter.String(pipelineResourceType),
+		ResourceId:   &resourceID,
+		Resource: &pipelinepermissions.ResourcePipelinePermissions{
+			AllPipelines: &pipelinepermissions.Permission{
+				Authorized: &authorized,
+			},
+		},
+	})
+	return err
+}
+
+// readAgentQueuePipelineAuthorization returns whether all pipelines are currently authorized to
+// use the agent queue, so that drift made outside of Terraform (e.g. via the UI) is detected.
+func readAgentQueuePipelineAuthorization(clients *config.AggregatedClient, project string, queueID int) (bool, error) {
+	resourceID := strconv.Itoa(queueID)
+	permissions, err := clients.PipelinePermissionsClient.GetPipelinePermissionsForResource(clients.Ctx, pipelinepermissions.GetPipelinePermissionsForResourceArgs{
+		Project:      &project,
+		ResourceType: converter.String(pipelineResourceType),
+		ResourceId:   &resourceID,
+	})
+
+	if utils.ResponseWasNotFound(err) {
+		// No permission record exists yet for this queue, which is the common case for queues
+		// that were never touched via the pipeline-permissions API (e.g. pre-existing queues or
+		// the default Hosted pool queues auto-provisioned per project).
+		return false, nil
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	if permissions == nil || permissions.AllPipelines == nil || permissions.AllPipelines.Authorized == nil {
+		return false, nil
+	}
+
+	return *permissions.AllPipelines.Authorized, nil
+}
+
 func expandAgentQueue(d *schema.ResourceData) (*taskagent.TaskAgentQueue, string, error) {
 	queue := &taskagent.TaskAgentQueue{
 		Pool: &taskagent.TaskAgentPoolReference{
@@ -75,11 +196,12 @@ func expandAgentQueue(d *schema.ResourceData) (*taskagent.TaskAgentQueue, string
 	}
 
 	if d.Id() != "" {
-		id, err := converter.ASCIIToIntPtr(d.Id())
+		id, err := parse.AgentQueueID(d.Id())
 		if err != nil {
-			return nil, "", fmt.Errorf("Queue ID was unexpectedly not a valid integer: %+v", err)
+			return nil, "", err
 		}
-		queue.Id = id
+		queue.Id = &id.QueueId
+		return queue, id.Project, nil
 	}
 
 	return queue, d.Get(projectID).(string), nil
@@ -87,14 +209,14 @@ func expandAgentQueue(d *schema.ResourceData) (*taskagent.TaskAgentQueue, string
 
 func resourceAgentQueueRead(d *schema.ResourceData, m interface{}) error {
 	clients := m.(*config.AggregatedClient)
-	queueID, err := converter.ASCIIToIntPtr(d.Id())
+	id, err := parse.AgentQueueID(d.Id())
 	if err != nil {
-		return fmt.Errorf("Queue ID was unexpectedly not a valid integer: %+v", err)
+		return err
 	}
 
 	queue, err := clients.TaskAgentClient.GetAgentQueue(clients.Ctx, taskagent.GetAgentQueueArgs{
-		QueueId: queueID,
-		Project: converter.String(d.Get(projectID).(string)),
+		QueueId: &id.QueueId,
+		Project: &id.Project,
 	})
 
 	if utils.ResponseWasNotFound(err) {
@@ -106,23 +228,31 @@ func resourceAgentQueueRead(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error reading the agent queue resource: %+v", err)
 	}
 
+	d.Set(projectID, id.Project)
+
 	if queue.Pool != nil && queue.Pool.Id != nil {
 		d.Set(agentPoolID, *queue.Pool.Id)
 	}
 
+	authorized, err := readAgentQueuePipelineAuthorization(clients, id.Project, id.QueueId)
+	if err != nil {
+		return fmt.Errorf("Error reading pipeline authorization for agent queue: %+v", err)
+	}
+	d.Set(authorizePipelines, authorized)
+
 	return nil
 }
 
 func resourceAgentQueueDelete(d *schema.ResourceData, m interface{}) error {
 	clients := m.(*config.AggregatedClient)
-	queueID, err := converter.ASCIIToIntPtr(d.Id())
+	id, err := parse.AgentQueueID(d.Id())
 	if err != nil {
-		return fmt.Errorf("Queue ID was unexpectedly not a valid integer: %+v", err)
+		return err
 	}
 
 	err = clients.TaskAgentClient.DeleteAgentQueue(clients.Ctx, taskagent.DeleteAgentQueueArgs{
-		QueueId: queueID,
-		Project: converter.String(d.Get(projectID).(string)),
+		QueueId: &id.QueueId,
+		Project: &id.Project,
 	})
 
 	if err != nil {
@@ -139,17 +269,50 @@ func importFunc() *schema.ResourceImporter {
 			id := d.Id()
 			parts := strings.SplitN(id, "/", 2)
 			if len(parts) != 2 || strings.EqualFold(parts[0], "") || strings.EqualFold(parts[1], "") {
-				return nil, fmt.Errorf("unexpected format of ID (%s), expected projectid/resourceId", id)
+				return nil, fmt.Errorf("unexpected format of ID (%s), expected projectId/queueId or projectId/queueName", id)
 			}
 
-			_, err := strconv.Atoi(parts[1])
+			project := parts[0]
+			queueID, err := strconv.Atoi(parts[1])
 			if err != nil {
-				return nil, fmt.Errorf("Agent queue ID (%s) isn't a valid Int", parts[1])
+				clients := meta.(*config.AggregatedClient)
+				queueID, err = lookupAgentQueueIDByName(clients, project, parts[1])
+				if err != nil {
+					return nil, err
+				}
 			}
 
-			d.Set(projectID, parts[0])
-			d.SetId(parts[1])
+			d.Set(projectID, project)
+			d.SetId(parse.NewAgentQueueID(project, queueID).ID())
 			return []*schema.ResourceData{d}, nil
 		},
 	}
 }
+
+// lookupAgentQueueIDByName resolves a queue name to its numeric ID within a project, so that
+// `terraform import` can accept the queue name shown in the UI instead of requiring callers to
+// look up the underlying ID first.
+func lookupAgentQueueIDByName(clients *config.AggregatedClient, project string, name string) (int, error) {
+	queues, err := clients.TaskAgentClient.GetAgentQueuesByNames(clients.Ctx, taskagent.GetAgentQueuesByNamesArgs{
+		Queues:  &[]string{name},
+		Project: &project,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("Error looking up agent queue named %q: %+v", name, err)
+	}
+
+	return selectAgentQueueIDByName(queues, project, name)
+}
+
+// selectAgentQueueIDByName picks the single queue ID matching name out of the queues returned by
+// GetAgentQueuesByNames, erroring clearly on zero or multiple matches.
+func selectAgentQueueIDByName(queues *[]taskagent.TaskAgentQueue, project string, name string) (int, error) {
+	if queues == nil || len(*queues) == 0 {
+		return 0, fmt.Errorf("Could not find an agent queue named %q in project %q", name, project)
+	}
+	if len(*queues) > 1 {
+		return 0, fmt.Errorf("Found multiple agent queues named %q in project %q, import by numeric queue ID instead", name, project)
+	}
+
+	return *(*queues)[0].Id, nil
+}
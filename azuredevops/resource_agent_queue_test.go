@@ -0,0 +1,64 @@
+package azuredevops
+
+import (
+	"testing"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/taskagent"
+)
+
+func TestSelectAgentQueueIDByName(t *testing.T) {
+	queueID := 42
+
+	cases := []struct {
+		Name   string
+		Queues *[]taskagent.TaskAgentQueue
+		Error  bool
+		Want   int
+	}{
+		{
+			Name:   "nil result",
+			Queues: nil,
+			Error:  true,
+		},
+		{
+			Name:   "zero matches",
+			Queues: &[]taskagent.TaskAgentQueue{},
+			Error:  true,
+		},
+		{
+			Name: "single match",
+			Queues: &[]taskagent.TaskAgentQueue{
+				{Id: &queueID},
+			},
+			Want: queueID,
+		},
+		{
+			Name: "multiple matches",
+			Queues: &[]taskagent.TaskAgentQueue{
+				{Id: &queueID},
+				{Id: &queueID},
+			},
+			Error: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			got, err := selectAgentQueueIDByName(tc.Queues, "my-project", "Default")
+			if tc.Error {
+				if err == nil {
+					t.Fatalf("expected an error but got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+
+			if got != tc.Want {
+				t.Fatalf("expected %d but got %d", tc.Want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,230 @@
+package azuredevops
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/pipelinepermissions"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/parse"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/config"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/suppress"
+)
+
+const (
+	resourceType = "resource_type"
+	resourceID   = "resource_id"
+	pipelineID   = "pipeline_id"
+	authorized   = "authorized"
+)
+
+func resourcePipelineAuthorization() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourcePipelineAuthorizationCreateUpdate,
+		Read:     resourcePipelineAuthorizationRead,
+		Update:   resourcePipelineAuthorizationCreateUpdate,
+		Delete:   resourcePipelineAuthorizationDelete,
+		Importer: pipelineAuthorizationImportFunc(),
+		Schema: map[string]*schema.Schema{
+			projectID: {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.NoZeroValues,
+				DiffSuppressFunc: suppress.CaseDifference,
+			},
+			resourceType: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"queue", "endpoint", "variablegroup", "environment",
+				}, false),
+			},
+			resourceID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			pipelineID: {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+			},
+			authorized: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func resourcePipelineAuthorizationCreateUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*config.AggregatedClient)
+	project := d.Get(projectID).(string)
+	resType := d.Get(resourceType).(string)
+	resID := d.Get(resourceID).(string)
+
+	var pid *int
+	if v, ok := d.GetOk(pipelineID); ok {
+		id := v.(int)
+		pid = &id
+	}
+
+	if err := validatePipelineAuthorizationResourceType(resType, pid); err != nil {
+		return err
+	}
+
+	isAuthorized := d.Get(authorized).(bool)
+	resource := pipelinepermissions.ResourcePipelinePermissions{}
+	if pid != nil {
+		resource.Pipelines = &[]pipelinepermissions.PipelinePermission{
+			{
+				Id: pid,
+				Authorization: &pipelinepermissions.Permission{
+					Authorized: &isAuthorized,
+				},
+			},
+		}
+	} else {
+		resource.AllPipelines = &pipelinepermissions.Permission{
+			Authorized: &isAuthorized,
+		}
+	}
+
+	_, err := clients.PipelinePermissionsClient.UpdatePipelinePermissionsForResource(clients.Ctx, pipelinepermissions.UpdatePipelinePermissionsForResourceArgs{
+		Project:      &project,
+		ResourceType: &resType,
+		ResourceId:   &resID,
+		Resource:     &resource,
+	})
+	if err != nil {
+		return fmt.Errorf("Error authorizing pipeline resource: %+v", err)
+	}
+
+	d.SetId(parse.NewPipelineAuthorizationID(project, resType, resID, pid).ID())
+	return resourcePipelineAuthorizationRead(d, m)
+}
+
+// validatePipelineAuthorizationResourceType rejects an all-pipelines (no pipeline_id)
+// authorization against a "queue" resource, since that overlaps with the `authorize_pipelines`
+// field already managed by `azuredevops_agent_queue` for the same underlying API value.
+func validatePipelineAuthorizationResourceType(resType string, pipelineID *int) error {
+	if pipelineID == nil && resType == pipelineResourceType {
+		return fmt.Errorf("`pipeline_id` is required when `resource_type` is %q: the all-pipelines authorization "+
+			"for an agent queue is already managed by the `authorize_pipelines` field on `azuredevops_agent_queue`", pipelineResourceType)
+	}
+	return nil
+}
+
+func resourcePipelineAuthorizationRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*config.AggregatedClient)
+	project := d.Get(projectID).(string)
+	resType := d.Get(resourceType).(string)
+	resID := d.Get(resourceID).(string)
+
+	permissions, err := clients.PipelinePermissionsClient.GetPipelinePermissionsForResource(clients.Ctx, pipelinepermissions.GetPipelinePermissionsForResourceArgs{
+		Project:      &project,
+		ResourceType: &resType,
+		ResourceId:   &resID,
+	})
+
+	if utils.ResponseWasNotFound(err) {
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("Error reading pipeline authorization: %+v", err)
+	}
+
+	if pid, ok := d.GetOk(pipelineID); ok {
+		found := false
+		if permissions != nil && permissions.Pipelines != nil {
+			for _, p := range *permissions.Pipelines {
+				if p.Id != nil && *p.Id == pid.(int) {
+					found = true
+					if p.Authorization != nil && p.Authorization.Authorized != nil {
+						d.Set(authorized, *p.Authorization.Authorized)
+					}
+					break
+				}
+			}
+		}
+
+		if !found {
+			d.SetId("")
+			return nil
+		}
+
+		return nil
+	}
+
+	if permissions != nil && permissions.AllPipelines != nil && permissions.AllPipelines.Authorized != nil {
+		d.Set(authorized, *permissions.AllPipelines.Authorized)
+	}
+
+	return nil
+}
+
+func resourcePipelineAuthorizationDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*config.AggregatedClient)
+	project := d.Get(projectID).(string)
+	resType := d.Get(resourceType).(string)
+	resID := d.Get(resourceID).(string)
+
+	resource := pipelinepermissions.ResourcePipelinePermissions{}
+	if pid, ok := d.GetOk(pipelineID); ok {
+		notAuthorized := false
+		resource.Pipelines = &[]pipelinepermissions.PipelinePermission{
+			{
+				Id: converter.Int(pid.(int)),
+				Authorization: &pipelinepermissions.Permission{
+					Authorized: &notAuthorized,
+				},
+			},
+		}
+	} else {
+		notAuthorized := false
+		resource.AllPipelines = &pipelinepermissions.Permission{
+			Authorized: &notAuthorized,
+		}
+	}
+
+	_, err := clients.PipelinePermissionsClient.UpdatePipelinePermissionsForResource(clients.Ctx, pipelinepermissions.UpdatePipelinePermissionsForResourceArgs{
+		Project:      &project,
+		ResourceType: &resType,
+		ResourceId:   &resID,
+		Resource:     &resource,
+	})
+	if err != nil {
+		return fmt.Errorf("Error revoking pipeline authorization: %+v", err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func pipelineAuthorizationImportFunc() *schema.ResourceImporter {
+	return &schema.ResourceImporter{
+		State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			id, err := parse.PipelineAuthorizationID(d.Id())
+			if err != nil {
+				return nil, err
+			}
+
+			d.Set(projectID, id.Project)
+			d.Set(resourceType, id.ResourceType)
+			d.Set(resourceID, id.ResourceId)
+			if id.PipelineId != nil {
+				d.Set(pipelineID, *id.PipelineId)
+			}
+
+			return []*schema.ResourceData{d}, nil
+		},
+	}
+}
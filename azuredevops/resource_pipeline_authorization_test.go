@@ -0,0 +1,46 @@
+package azuredevops
+
+import "testing"
+
+func TestValidatePipelineAuthorizationResourceType(t *testing.T) {
+	pipelineID := 42
+
+	cases := []struct {
+		Name       string
+		ResType    string
+		PipelineID *int
+		Error      bool
+	}{
+		{
+			Name:    "queue without pipeline_id is rejected",
+			ResType: "queue",
+			Error:   true,
+		},
+		{
+			Name:       "queue with pipeline_id is allowed",
+			ResType:    "queue",
+			PipelineID: &pipelineID,
+		},
+		{
+			Name:    "endpoint without pipeline_id is allowed",
+			ResType: "endpoint",
+		},
+		{
+			Name:       "endpoint with pipeline_id is allowed",
+			ResType:    "endpoint",
+			PipelineID: &pipelineID,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			err := validatePipelineAuthorizationResourceType(tc.ResType, tc.PipelineID)
+			if tc.Error && err == nil {
+				t.Fatalf("expected an error but got none")
+			}
+			if !tc.Error && err != nil {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+		})
+	}
+}